@@ -19,6 +19,11 @@ import (
 // Bool is a nullable bool. False input is considered null.
 // JSON marshals to false if null.
 // Considered null to SQL unmarshalled from a false value.
+//
+// Deprecated: this type is kept here unchanged for back-compat.
+// New code should use go-core/libs/zero (identical behaviour, explicit
+// name) or go-core/libs/null (false/0/"" are valid, only JSON/SQL NULL is
+// null).
 type Bool struct {
 	sql.NullBool
 }
@@ -33,6 +38,15 @@ func NewBool(b bool) Bool {
 	}
 }
 
+// BoolFromPtr creates a new Bool from a *bool.
+// A nil pointer produces an invalid (null) Bool.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return Bool{}
+	}
+	return NewBool(*b)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 // It also supports unmarshalling a sql.NullBool.
@@ -143,6 +157,22 @@ func (b Bool) Get() bool {
 	return b.Bool
 }
 
+// ValueOrZero returns the inner value if valid, otherwise false.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+	return b.Bool
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (b Bool) OrElse(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
 func (b Bool) String() string {
 	if !b.Valid {
 		return ""
@@ -1,6 +1,7 @@
 package libs
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -8,23 +9,39 @@ import (
 	"time"
 )
 
-// Time is a nullable time.Time.
+// Date is a nullable, date-only time.Time (no time-of-day component).
 // JSON marshals to the zero value for time.Time if null.
 // Considered to be null to SQL if zero.
 
+// DateTimeLayOut is the layout used when marshalling a Date to JSON.
+// MarshalText does not use it; it writes time.Time's RFC 3339 text form.
 var DateTimeLayOut = "2006-01-02"
 
+// DateParseLayouts is the ordered list of layouts tried, in turn, when
+// unmarshalling a Date from text. RFC3339 is included by default so
+// ISO-8601 payloads are accepted without reconfiguration; whatever
+// time-of-day they carry is truncated away after parsing. Append to this
+// slice to accept further formats without touching DateTimeLayOut.
+var DateParseLayouts = []string{DateTimeLayOut, time.RFC3339, time.RFC3339Nano}
+
+// DateLocation is the location a Date is truncated to midnight in by Scan,
+// and the location its midnight Value is expressed in. Defaults to
+// time.Local; override for callers that need a fixed DATE-column location.
+var DateLocation = time.Local
+
 type Date struct {
 	Time  time.Time
 	Valid bool
 }
 
 // Scan implements Scanner interface.
+// Incoming time.Time values are truncated to midnight in DateLocation so
+// DATE columns round-trip losslessly.
 func (t *Date) Scan(value interface{}) error {
 	var err error
 	switch x := value.(type) {
 	case time.Time:
-		t.Time = x
+		t.Time = truncateToDate(x)
 	case nil:
 		t.Valid = false
 		return nil
@@ -36,21 +53,36 @@ func (t *Date) Scan(value interface{}) error {
 }
 
 // Value implements the driver Valuer interface.
+// It returns a time.Time at 00:00:00 in DateLocation.
 func (t Date) Value() (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
 	}
-	return t.Time, nil
+	return truncateToDate(t.Time), nil
 }
 
-// NewDate NewTime creates a new Time.
-func NewDate(t time.Time) Time {
-	return Time{
-		Time:  t,
+// NewDate creates a new Date.
+func NewDate(t time.Time) Date {
+	return Date{
+		Time:  truncateToDate(t),
 		Valid: true,
 	}
 }
 
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.In(DateLocation).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, DateLocation)
+}
+
+// DateFromPtr creates a new Date from a *time.Time.
+// A nil pointer produces an invalid (null) Date.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return Date{}
+	}
+	return NewDate(*t)
+}
+
 // MarshalJSON implements json.Marshaller.
 // It will encode the zero value of time.Time
 // if this time is invalid.
@@ -62,8 +94,9 @@ func (t Date) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string, object (e.g. pq.NullTime and friends)
-// and null input.
+// It supports string (matched against DateParseLayouts), a JSON number
+// (treated as a Unix timestamp, seconds or milliseconds depending on
+// magnitude), object (e.g. sql.NullTime and friends) and null input.
 func (t *Date) UnmarshalJSON(data []byte) error {
 
 	var value interface{}
@@ -72,9 +105,23 @@ func (t *Date) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	switch value.(type) {
+	switch x := value.(type) {
 	case string:
-		return t.UnmarshalText([]byte(value.(string)))
+		return t.UnmarshalText([]byte(x))
+	case float64:
+		t.Time = truncateToDate(timeFromUnix(x))
+		t.Valid = true
+		return nil
+	case map[string]interface{}:
+		var nt sql.NullTime
+		if err := json.Unmarshal(data, &nt); err != nil {
+			return err
+		}
+		if nt.Valid {
+			t.Time = truncateToDate(nt.Time)
+		}
+		t.Valid = nt.Valid
+		return nil
 	case nil:
 		t.Time = time.Time{}
 		t.Valid = false
@@ -102,16 +149,18 @@ func (t *Date) UnmarshalText(text []byte) error {
 		return nil
 	}
 
-	str = str + " 00:00:00"
-
-	tt, err := time.ParseInLocation(TimeLayOut, str, time.Local)
-
-	if err != nil {
-		return err
+	var tt time.Time
+	var err error
+	for _, layout := range DateParseLayouts {
+		tt, err = time.ParseInLocation(layout, str, DateLocation)
+		if err == nil {
+			y, m, d := tt.Date()
+			t.Time = time.Date(y, m, d, 0, 0, 0, 0, DateLocation)
+			t.Valid = true
+			return nil
+		}
 	}
-	t.Time = tt
-	t.Valid = true
-	return nil
+	return err
 }
 
 // SetValid changes this Time's value and
@@ -139,3 +188,54 @@ func (t Date) IsNil() bool {
 func (t Date) Get() time.Time {
 	return t.Time
 }
+
+// ValueOrZero returns the inner value if valid, otherwise the zero time.Time.
+func (t Date) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (t Date) OrElse(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// Add returns the Date days days after t, preserving validity.
+func (t Date) Add(days int) Date {
+	if !t.Valid {
+		return t
+	}
+	return Date{Time: t.Time.AddDate(0, 0, days), Valid: true}
+}
+
+// Before reports whether t is strictly before u. It returns false whenever
+// either Date is invalid.
+func (t Date) Before(u Date) bool {
+	return t.Valid && u.Valid && t.Time.Before(u.Time)
+}
+
+// After reports whether t is strictly after u. It returns false whenever
+// either Date is invalid.
+func (t Date) After(u Date) bool {
+	return t.Valid && u.Valid && t.Time.After(u.Time)
+}
+
+// Equal reports whether t and u represent the same date.
+func (t Date) Equal(u Date) bool {
+	return t.Valid == u.Valid && (!t.Valid || t.Time.Equal(u.Time))
+}
+
+// Sub returns the number of calendar days between t and u (t - u), computed
+// from the date components rather than the elapsed duration so it stays
+// correct across DateLocation DST transitions.
+func (t Date) Sub(u Date) int {
+	const dayHours = 24 * time.Hour
+	tDay := time.Date(t.Time.Year(), t.Time.Month(), t.Time.Day(), 0, 0, 0, 0, time.UTC)
+	uDay := time.Date(u.Time.Year(), u.Time.Month(), u.Time.Day(), 0, 0, 0, 0, time.UTC)
+	return int(tDay.Sub(uDay) / dayHours)
+}
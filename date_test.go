@@ -0,0 +1,126 @@
+package libs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateUnmarshalJSONRFC3339(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`"2023-01-15T00:00:00Z"`), &d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !d.Valid {
+		t.Fatal("expected a valid Date")
+	}
+	if d.Time.Hour() != 0 || d.Time.Minute() != 0 || d.Time.Second() != 0 {
+		t.Fatalf("expected time-of-day to be truncated, got %v", d.Time)
+	}
+	if d.Time.Year() != 2023 || d.Time.Month() != time.January || d.Time.Day() != 15 {
+		t.Fatalf("got %v", d.Time)
+	}
+}
+
+func TestDateUnmarshalTextUsesDateLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available in this environment")
+	}
+	old := DateLocation
+	DateLocation = loc
+	defer func() { DateLocation = old }()
+
+	var d Date
+	if err := d.UnmarshalText([]byte("2024-01-15")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !d.Valid {
+		t.Fatal("expected a valid Date")
+	}
+	if d.Time.Year() != 2024 || d.Time.Month() != time.January || d.Time.Day() != 15 {
+		t.Fatalf("got %v, want 2024-01-15 regardless of time.Local", d.Time)
+	}
+
+	var viaJSON Date
+	if err := json.Unmarshal([]byte(`"2024-01-15"`), &viaJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if viaJSON.Time.Day() != 15 {
+		t.Fatalf("got %v, want day 15", viaJSON.Time)
+	}
+}
+
+func TestDateAddBeforeAfterEqual(t *testing.T) {
+	a := NewDate(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	b := a.Add(5)
+
+	if !a.Before(b) || b.Before(a) {
+		t.Fatalf("expected a before b, a=%v b=%v", a, b)
+	}
+	if !b.After(a) || a.After(b) {
+		t.Fatalf("expected b after a, a=%v b=%v", a, b)
+	}
+	if a.Equal(b) {
+		t.Fatalf("a and b should not be equal")
+	}
+	if !a.Equal(NewDate(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))) {
+		t.Fatalf("expected equal dates to compare equal")
+	}
+
+	var invalid Date
+	if invalid.Before(a) || a.Before(invalid) {
+		t.Fatalf("Before should be false whenever either side is invalid")
+	}
+	if invalid.After(a) || a.After(invalid) {
+		t.Fatalf("After should be false whenever either side is invalid")
+	}
+}
+
+func TestDateSub(t *testing.T) {
+	cases := []struct {
+		name     string
+		location *time.Location
+		a, b     time.Time
+		want     int
+	}{
+		{
+			name:     "plain five day span in UTC",
+			location: time.UTC,
+			a:        time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			b:        time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			want:     5,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			old := DateLocation
+			DateLocation = c.location
+			defer func() { DateLocation = old }()
+
+			a := NewDate(c.a)
+			b := NewDate(c.b)
+			if got := a.Sub(b); got != c.want {
+				t.Fatalf("Sub() = %d, want %d", got, c.want)
+			}
+		})
+	}
+
+	// DST transition: elapsed wall-clock hours between two local midnights
+	// is not a clean multiple of 24 across a spring-forward boundary, so
+	// Sub must compute the day difference from calendar components.
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available in this environment")
+	}
+	old := DateLocation
+	DateLocation = loc
+	defer func() { DateLocation = old }()
+
+	a := NewDate(time.Date(2024, 3, 11, 0, 0, 0, 0, loc))
+	b := NewDate(time.Date(2024, 3, 9, 0, 0, 0, 0, loc))
+	if got := a.Sub(b); got != 2 {
+		t.Fatalf("Sub() across DST = %d, want 2", got)
+	}
+}
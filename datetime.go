@@ -0,0 +1,184 @@
+package libs
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateTime is a nullable time.Time that marshals using ISO-8601/RFC3339
+// semantics instead of the "2006-01-02 15:04:05" layout used by Time.
+// JSON marshals to null if invalid. Value reports SQL NULL only when invalid;
+// a valid zero time is passed through as is.
+
+// DateTimeISOLayOut is the layout used when marshalling a DateTime to JSON/text.
+var DateTimeISOLayOut = time.RFC3339
+
+// DateTimeParseLayouts is the ordered list of layouts tried, in turn, when
+// unmarshalling a DateTime from text.
+var DateTimeParseLayouts = []string{time.RFC3339, time.RFC3339Nano}
+
+type DateTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+// Scan implements the Scanner interface.
+func (t *DateTime) Scan(value interface{}) error {
+	var err error
+	switch x := value.(type) {
+	case time.Time:
+		t.Time = x
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.DateTime: %v", value, value)
+	}
+	t.Valid = err == nil
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (t DateTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// NewDateTime creates a new DateTime.
+func NewDateTime(t time.Time) DateTime {
+	return DateTime{
+		Time:  t,
+		Valid: true,
+	}
+}
+
+// DateTimeFromPtr creates a new DateTime from a *time.Time.
+// A nil pointer produces an invalid (null) DateTime.
+func DateTimeFromPtr(t *time.Time) DateTime {
+	if t == nil {
+		return DateTime{}
+	}
+	return NewDateTime(*t)
+}
+
+// MarshalJSON implements json.Marshaller.
+// It encodes null if this DateTime is invalid, otherwise an RFC3339 string.
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(DateTimeISOLayOut))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string (matched against DateTimeParseLayouts), a JSON number
+// (treated as a Unix timestamp, seconds or milliseconds depending on
+// magnitude), object (e.g. sql.NullTime and friends) and null input.
+func (t *DateTime) UnmarshalJSON(data []byte) error {
+
+	var value interface{}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch x := value.(type) {
+	case string:
+		return t.UnmarshalText([]byte(x))
+	case float64:
+		t.Time = timeFromUnix(x)
+		t.Valid = true
+		return nil
+	case map[string]interface{}:
+		var nt sql.NullTime
+		if err := json.Unmarshal(data, &nt); err != nil {
+			return err
+		}
+		t.Time = nt.Time
+		t.Valid = nt.Valid
+		return nil
+	case nil:
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	default:
+		return errors.New("不支持的序列化类型")
+	}
+
+}
+
+// MarshalText implements encoding.TextMarshaller.
+func (t DateTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte(nil), nil
+	}
+	return []byte(t.Time.Format(DateTimeISOLayOut)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *DateTime) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		t.Valid = false
+		return nil
+	}
+
+	var tt time.Time
+	var err error
+	for _, layout := range DateTimeParseLayouts {
+		tt, err = time.Parse(layout, str)
+		if err == nil {
+			t.Time = tt
+			t.Valid = true
+			return nil
+		}
+	}
+	return err
+}
+
+// SetValid changes this DateTime's value and sets it to be non-null.
+func (t *DateTime) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this DateTime's value,
+// or a nil pointer if this DateTime is invalid.
+func (t DateTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsNil returns true for null or zero DateTimes, for potential future omitempty support.
+func (t DateTime) IsNil() bool {
+	return !t.Valid || t.Time.IsZero()
+}
+
+// Get 获取原始日期时间
+func (t DateTime) Get() time.Time {
+	return t.Time
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero time.Time.
+func (t DateTime) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (t DateTime) OrElse(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
@@ -12,6 +12,11 @@ import (
 // Float is a nullable float64. Zero input will be considered null.
 // JSON marshals to zero if null.
 // Considered null to SQL if zero.
+//
+// Deprecated: this zero-is-null behaviour is kept here for back-compat.
+// New code should use go-core/libs/zero (identical behaviour, explicit
+// name) or go-core/libs/null (false/0/"" are valid, only JSON/SQL NULL is
+// null).
 type Float struct {
 	sql.NullFloat64
 }
@@ -26,6 +31,15 @@ func NewFloat(f float64) Float {
 	}
 }
 
+// FloatFromPtr creates a new Float from a *float64.
+// A nil pointer produces an invalid (null) Float.
+func FloatFromPtr(f *float64) Float {
+	if f == nil {
+		return Float{}
+	}
+	return NewFloat(*f)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Float.
@@ -133,3 +147,19 @@ func (f Float) String() string {
 func (f Float) Opposite() Float {
 	return NewFloat(-f.Float64)
 }
+
+// ValueOrZero returns the inner value if valid, otherwise 0.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (f Float) OrElse(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
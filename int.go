@@ -18,6 +18,11 @@ import (
 // Int is a nullable int64.
 // JSON marshals to zero if null.
 // Considered null to SQL if zero.
+//
+// Deprecated: this zero-is-null behaviour is kept here for back-compat.
+// New code should use go-core/libs/zero (identical behaviour, explicit
+// name) or go-core/libs/null (false/0/"" are valid, only JSON/SQL NULL is
+// null).
 type Int struct {
 	sql.NullInt64
 }
@@ -32,6 +37,15 @@ func NewInt(i int64) Int {
 	}
 }
 
+// IntFromPtr creates a new Int from a *int64.
+// A nil pointer produces an invalid (null) Int.
+func IntFromPtr(i *int64) Int {
+	if i == nil {
+		return Int{}
+	}
+	return NewInt(*i)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int.
@@ -127,3 +141,19 @@ func (i *Int) Get() int {
 func (i *Int) String() string {
 	return strconv.Itoa(i.Get())
 }
+
+// ValueOrZero returns the inner value if valid, otherwise 0.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (i Int) OrElse(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
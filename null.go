@@ -0,0 +1,118 @@
+package libs
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Null is a generic nullable wrapper for database/sql and JSON. The
+// concrete Bool/Int/Float/String/Time/Date types of this package do not
+// use it; further nullable types (uint64, civil.Date, uuid.UUID, ...) can
+// be added as a type alias of it instead of copy-pasting Scan/Value/
+// MarshalJSON/UnmarshalJSON each time.
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewNull creates a new, valid Null[T].
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// NullFromPtr creates a new Null[T] from a *T.
+// A nil pointer produces an invalid (null) Null[T].
+func NullFromPtr[T any](v *T) Null[T] {
+	if v == nil {
+		return Null[T]{}
+	}
+	return NewNull(*v)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Null[T]) Scan(value interface{}) error {
+	if value == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+
+	switch v := value.(type) {
+	case T:
+		n.V = v
+	case []byte:
+		if s, ok := any(&n.V).(*string); ok {
+			*s = string(v)
+		} else if err := json.Unmarshal(v, &n.V); err != nil {
+			return fmt.Errorf("libs: cannot scan []byte into Null[%T]: %w", n.V, err)
+		}
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("libs: cannot scan %T into Null[%T]: %w", value, n.V, err)
+		}
+		if err := json.Unmarshal(raw, &n.V); err != nil {
+			return fmt.Errorf("libs: cannot scan %T into Null[%T]: %w", value, n.V, err)
+		}
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// MarshalJSON implements json.Marshaller.
+// It will encode null if this Null is invalid.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero value of T.
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.V
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (n Null[T]) OrElse(def T) T {
+	if !n.Valid {
+		return def
+	}
+	return n.V
+}
+
+// Ptr returns a pointer to this Null's value, or a nil pointer if invalid.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
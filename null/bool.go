@@ -0,0 +1,163 @@
+// Package null mirrors go-core/libs' nullable types with "zero value" and
+// "SQL NULL" kept distinct: false, 0 and "" are valid values. Only an
+// explicit JSON null or SQL NULL makes a value invalid. See go-core/libs/zero
+// for the original, zero-is-null behaviour kept for back-compat.
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Bool is a nullable bool. Unlike go-core/libs/zero.Bool, a false value is
+// valid; only JSON null / SQL NULL is considered null.
+type Bool struct {
+	sql.NullBool
+}
+
+// NewBool creates a new, valid Bool.
+func NewBool(b bool) Bool {
+	return Bool{
+		NullBool: sql.NullBool{
+			Bool:  b,
+			Valid: true,
+		},
+	}
+}
+
+// BoolFromPtr creates a new Bool from a *bool.
+// A nil pointer produces an invalid (null) Bool.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return Bool{}
+	}
+	return NewBool(*b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It also supports unmarshalling a sql.NullBool.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case bool:
+		b.Bool = x
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &b.NullBool)
+	case nil:
+		b.Valid = false
+		return nil
+	case string:
+		if len(x) == 0 {
+			b.Valid = false
+			return nil
+		}
+		b.Bool, err = strconv.ParseBool(x)
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", reflect.TypeOf(v).Name())
+	}
+	b.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bool if the input is blank or "null".
+// It will return an error if the input is not a bool, blank, or "null".
+func (b *Bool) UnmarshalText(text []byte) error {
+	str := string(text)
+	switch str {
+	case "", "null":
+		b.Bool = false
+		b.Valid = false
+		return nil
+	case "true", "1":
+		b.Valid = true
+		b.Bool = true
+	case "false", "0":
+		b.Bool = false
+		b.Valid = true
+	default:
+		b.Valid = false
+		return errors.New("invalid input:" + str)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaller.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	if !b.Bool {
+		return []byte("false"), nil
+	}
+	return []byte("true"), nil
+}
+
+// MarshalText implements encoding.TextMarshaller.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	if !b.Bool {
+		return []byte("false"), nil
+	}
+	return []byte("true"), nil
+}
+
+// SetValid changes this Bool value and also sets it to be non-null.
+func (b *Bool) SetValid(v bool) {
+	b.Bool = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bool value, or a nil pointer if this Bool is null.
+func (b Bool) Ptr() *bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bool
+}
+
+// IsNil returns true only for a null Bool, for future omitempty support (Go 1.4?)
+func (b Bool) IsNil() bool {
+	return !b.Valid
+}
+
+//Get returns base value for Bools
+func (b Bool) Get() bool {
+	return b.Bool
+}
+
+// ValueOrZero returns the inner value if valid, otherwise false.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+	return b.Bool
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (b Bool) OrElse(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
+func (b Bool) String() string {
+	if !b.Valid {
+		return ""
+	}
+	return fmt.Sprint(b.Get())
+}
@@ -0,0 +1,156 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Float is a nullable float64. Unlike go-core/libs/zero.Float, 0 is a
+// valid value; only JSON null / SQL NULL is considered null.
+type Float struct {
+	sql.NullFloat64
+}
+
+// NewFloat creates a new, valid Float.
+func NewFloat(f float64) Float {
+	return Float{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   true,
+		},
+	}
+}
+
+// FloatFromPtr creates a new Float from a *float64.
+// A nil pointer produces an invalid (null) Float.
+func FloatFromPtr(f *float64) Float {
+	if f == nil {
+		return Float{}
+	}
+	return NewFloat(*f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// It also supports unmarshalling a sql.NullFloat64.
+func (f *Float) UnmarshalJSON(data []byte) error {
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		f.Float64 = x
+	case string:
+		str := x
+		if len(str) == 0 {
+			f.Valid = false
+			return nil
+		}
+		f.Float64, err = strconv.ParseFloat(str, 64)
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &f.NullFloat64)
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", reflect.TypeOf(v).Name())
+	}
+	f.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float if the input is blank or "null".
+// It will return an error if the input is not a float, blank, or "null".
+func (f *Float) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	var err error
+	f.Float64, err = strconv.ParseFloat(str, 64)
+	f.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaller.
+// It will encode null if this Float is null.
+func (f Float) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
+		return nil, &json.UnsupportedValueError{
+			Value: reflect.ValueOf(f.Float64),
+			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
+		}
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// MarshalText implements encoding.TextMarshaller.
+// It will encode null if this Float is null.
+func (f Float) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// SetValid changes this Float's value and also sets it to be non-null.
+func (f *Float) SetValid(v float64) {
+	f.Float64 = v
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float's value, or a nil pointer if this Float is null.
+func (f Float) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// IsNil returns true only for a null Float, for future omitempty support (Go 1.4?)
+func (f Float) IsNil() bool {
+	return !f.Valid
+}
+
+//Get 返回原始 float 值
+func (f Float) Get() float64 {
+	return f.Float64
+}
+
+func (f Float) String() string {
+	if !f.Valid {
+		return ""
+	}
+	return fmt.Sprint(f.Float64)
+}
+
+func (f Float) Opposite() Float {
+	return NewFloat(-f.Float64)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise 0.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (f Float) OrElse(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
@@ -0,0 +1,45 @@
+package null
+
+import "testing"
+
+func TestNewIntZeroIsValid(t *testing.T) {
+	if !NewInt(0).Valid {
+		t.Fatal("NewInt(0) should be valid under zero-is-valid semantics")
+	}
+}
+
+func TestNewBoolFalseIsValid(t *testing.T) {
+	if !NewBool(false).Valid {
+		t.Fatal("NewBool(false) should be valid under zero-is-valid semantics")
+	}
+}
+
+func TestNewFloatZeroIsValid(t *testing.T) {
+	if !NewFloat(0).Valid {
+		t.Fatal("NewFloat(0) should be valid under zero-is-valid semantics")
+	}
+}
+
+func TestNewStringEmptyIsValid(t *testing.T) {
+	s := NewString("")
+	if !s.Valid() {
+		t.Fatal(`NewString("") should be valid under zero-is-valid semantics`)
+	}
+}
+
+func TestIntUnmarshalTextZero(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalText([]byte("0")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !i.Valid || i.Int64 != 0 {
+		t.Fatalf("got %+v, want valid 0", i)
+	}
+
+	if err := i.UnmarshalText([]byte("null")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if i.Valid {
+		t.Fatal(`unmarshalling "null" should leave Int invalid`)
+	}
+}
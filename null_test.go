@@ -0,0 +1,105 @@
+package libs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullIntScan(t *testing.T) {
+	cases := []struct {
+		name      string
+		value     interface{}
+		wantValid bool
+		want      int64
+	}{
+		{name: "nil value is invalid", value: nil, wantValid: false},
+		{name: "scan the concrete type directly", value: int64(42), wantValid: true, want: 42},
+		{name: "scan []byte via JSON", value: []byte("42"), wantValid: true, want: 42},
+		{name: "scan a convertible type via JSON round-trip", value: int32(7), wantValid: true, want: 7},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var n Null[int64]
+			if err := n.Scan(c.value); err != nil {
+				t.Fatalf("Scan(%v) error: %v", c.value, err)
+			}
+			if n.Valid != c.wantValid {
+				t.Fatalf("Valid = %v, want %v", n.Valid, c.wantValid)
+			}
+			if n.Valid && n.V != c.want {
+				t.Fatalf("V = %v, want %v", n.V, c.want)
+			}
+		})
+	}
+}
+
+func TestNullStringScanBytes(t *testing.T) {
+	var n Null[string]
+	if err := n.Scan([]byte("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !n.Valid || n.V != "hello" {
+		t.Fatalf("got %+v, want valid hello", n)
+	}
+}
+
+func TestNullJSONRoundTrip(t *testing.T) {
+	n := NewNull(int64(5))
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "5" {
+		t.Fatalf("got %s, want 5", data)
+	}
+
+	var back Null[int64]
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !back.Valid || back.V != 5 {
+		t.Fatalf("got %+v, want valid 5", back)
+	}
+
+	var nullValue Null[int64]
+	if err := json.Unmarshal([]byte("null"), &nullValue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nullValue.Valid {
+		t.Fatalf("expected invalid Null after unmarshalling null")
+	}
+
+	invalid := Null[int64]{}
+	data, err = json.Marshal(invalid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "null" {
+		t.Fatalf("got %s, want null", data)
+	}
+}
+
+func TestNullHelpers(t *testing.T) {
+	valid := NewNull("hi")
+	if valid.ValueOrZero() != "hi" {
+		t.Fatalf("ValueOrZero() = %v, want hi", valid.ValueOrZero())
+	}
+	if valid.OrElse("bye") != "hi" {
+		t.Fatalf("OrElse() = %v, want hi", valid.OrElse("bye"))
+	}
+	if ptr := valid.Ptr(); ptr == nil || *ptr != "hi" {
+		t.Fatalf("Ptr() = %v, want pointer to hi", ptr)
+	}
+
+	var invalid Null[string]
+	if invalid.ValueOrZero() != "" {
+		t.Fatalf("ValueOrZero() = %q, want empty string", invalid.ValueOrZero())
+	}
+	if invalid.OrElse("bye") != "bye" {
+		t.Fatalf("OrElse() = %v, want bye", invalid.OrElse("bye"))
+	}
+	if ptr := invalid.Ptr(); ptr != nil {
+		t.Fatalf("Ptr() = %v, want nil", ptr)
+	}
+}
@@ -0,0 +1,256 @@
+// Package pbnull bridges go-core/libs' nullable types to the
+// google.protobuf.{Int64Value, DoubleValue, BoolValue, StringValue,
+// Timestamp} wrapper messages, so gRPC services can accept and return
+// these types directly instead of hand-writing a translation layer on
+// every RPC. Each type gets a ToWrapperspb/FromWrapperspb pair plus a
+// MarshalProto/UnmarshalProto pair for callers that want the raw wire
+// bytes of the wrapper message.
+package pbnull
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go-core/libs"
+)
+
+// IntToWrapperspb converts an Int to a *wrapperspb.Int64Value, or nil if invalid.
+func IntToWrapperspb(i libs.Int) *wrapperspb.Int64Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(i.Int64)
+}
+
+// IntFromWrapperspb converts a *wrapperspb.Int64Value to an Int.
+// A nil input produces an invalid (null) Int.
+func IntFromWrapperspb(v *wrapperspb.Int64Value) libs.Int {
+	if v == nil {
+		return libs.Int{}
+	}
+	return libs.NewInt(v.GetValue())
+}
+
+// MarshalIntProto encodes an Int as the wire bytes of a wrapperspb.Int64Value.
+// An invalid Int marshals to nil bytes.
+func MarshalIntProto(i libs.Int) ([]byte, error) {
+	w := IntToWrapperspb(i)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalIntProto decodes the wire bytes of a wrapperspb.Int64Value into an Int.
+// Empty input produces an invalid (null) Int.
+func UnmarshalIntProto(data []byte) (libs.Int, error) {
+	if len(data) == 0 {
+		return libs.Int{}, nil
+	}
+	var w wrapperspb.Int64Value
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.Int{}, err
+	}
+	return libs.NewInt(w.GetValue()), nil
+}
+
+// FloatToWrapperspb converts a Float to a *wrapperspb.DoubleValue, or nil if invalid.
+func FloatToWrapperspb(f libs.Float) *wrapperspb.DoubleValue {
+	if !f.Valid {
+		return nil
+	}
+	return wrapperspb.Double(f.Float64)
+}
+
+// FloatFromWrapperspb converts a *wrapperspb.DoubleValue to a Float.
+// A nil input produces an invalid (null) Float.
+func FloatFromWrapperspb(v *wrapperspb.DoubleValue) libs.Float {
+	if v == nil {
+		return libs.Float{}
+	}
+	return libs.NewFloat(v.GetValue())
+}
+
+// MarshalFloatProto encodes a Float as the wire bytes of a wrapperspb.DoubleValue.
+// An invalid Float marshals to nil bytes.
+func MarshalFloatProto(f libs.Float) ([]byte, error) {
+	w := FloatToWrapperspb(f)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalFloatProto decodes the wire bytes of a wrapperspb.DoubleValue into a Float.
+// Empty input produces an invalid (null) Float.
+func UnmarshalFloatProto(data []byte) (libs.Float, error) {
+	if len(data) == 0 {
+		return libs.Float{}, nil
+	}
+	var w wrapperspb.DoubleValue
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.Float{}, err
+	}
+	return libs.NewFloat(w.GetValue()), nil
+}
+
+// BoolToWrapperspb converts a Bool to a *wrapperspb.BoolValue, or nil if invalid.
+func BoolToWrapperspb(b libs.Bool) *wrapperspb.BoolValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bool(b.Bool)
+}
+
+// BoolFromWrapperspb converts a *wrapperspb.BoolValue to a Bool.
+// A nil input produces an invalid (null) Bool.
+func BoolFromWrapperspb(v *wrapperspb.BoolValue) libs.Bool {
+	if v == nil {
+		return libs.Bool{}
+	}
+	return libs.NewBool(v.GetValue())
+}
+
+// MarshalBoolProto encodes a Bool as the wire bytes of a wrapperspb.BoolValue.
+// An invalid Bool marshals to nil bytes.
+func MarshalBoolProto(b libs.Bool) ([]byte, error) {
+	w := BoolToWrapperspb(b)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalBoolProto decodes the wire bytes of a wrapperspb.BoolValue into a Bool.
+// Empty input produces an invalid (null) Bool.
+func UnmarshalBoolProto(data []byte) (libs.Bool, error) {
+	if len(data) == 0 {
+		return libs.Bool{}, nil
+	}
+	var w wrapperspb.BoolValue
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.Bool{}, err
+	}
+	return libs.NewBool(w.GetValue()), nil
+}
+
+// StringToWrapperspb converts a String to a *wrapperspb.StringValue, or nil if invalid.
+func StringToWrapperspb(s libs.String) *wrapperspb.StringValue {
+	if !s.Valid() {
+		return nil
+	}
+	return wrapperspb.String(s.Get())
+}
+
+// StringFromWrapperspb converts a *wrapperspb.StringValue to a String.
+// A nil input produces an invalid (null) String.
+func StringFromWrapperspb(v *wrapperspb.StringValue) libs.String {
+	if v == nil {
+		return libs.String{}
+	}
+	return libs.NewString(v.GetValue())
+}
+
+// MarshalStringProto encodes a String as the wire bytes of a wrapperspb.StringValue.
+// An invalid String marshals to nil bytes.
+func MarshalStringProto(s libs.String) ([]byte, error) {
+	w := StringToWrapperspb(s)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalStringProto decodes the wire bytes of a wrapperspb.StringValue into a String.
+// Empty input produces an invalid (null) String.
+func UnmarshalStringProto(data []byte) (libs.String, error) {
+	if len(data) == 0 {
+		return libs.String{}, nil
+	}
+	var w wrapperspb.StringValue
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.String{}, err
+	}
+	return libs.NewString(w.GetValue()), nil
+}
+
+// TimeToWrapperspb converts a Time to a *timestamppb.Timestamp, or nil if invalid.
+func TimeToWrapperspb(t libs.Time) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// TimeFromWrapperspb converts a *timestamppb.Timestamp to a Time.
+// A nil input produces an invalid (null) Time.
+func TimeFromWrapperspb(v *timestamppb.Timestamp) libs.Time {
+	if v == nil {
+		return libs.Time{}
+	}
+	return libs.NewTime(v.AsTime())
+}
+
+// MarshalTimeProto encodes a Time as the wire bytes of a timestamppb.Timestamp.
+// An invalid Time marshals to nil bytes.
+func MarshalTimeProto(t libs.Time) ([]byte, error) {
+	w := TimeToWrapperspb(t)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalTimeProto decodes the wire bytes of a timestamppb.Timestamp into a Time.
+// Empty input produces an invalid (null) Time.
+func UnmarshalTimeProto(data []byte) (libs.Time, error) {
+	if len(data) == 0 {
+		return libs.Time{}, nil
+	}
+	var w timestamppb.Timestamp
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.Time{}, err
+	}
+	return libs.NewTime(w.AsTime()), nil
+}
+
+// DateToWrapperspb converts a Date to a *timestamppb.Timestamp at midnight, or nil if invalid.
+func DateToWrapperspb(d libs.Date) *timestamppb.Timestamp {
+	if !d.Valid {
+		return nil
+	}
+	return timestamppb.New(d.Time)
+}
+
+// DateFromWrapperspb converts a *timestamppb.Timestamp to a Date.
+// A nil input produces an invalid (null) Date.
+func DateFromWrapperspb(v *timestamppb.Timestamp) libs.Date {
+	if v == nil {
+		return libs.Date{}
+	}
+	return libs.NewDate(v.AsTime())
+}
+
+// MarshalDateProto encodes a Date as the wire bytes of a timestamppb.Timestamp.
+// An invalid Date marshals to nil bytes.
+func MarshalDateProto(d libs.Date) ([]byte, error) {
+	w := DateToWrapperspb(d)
+	if w == nil {
+		return nil, nil
+	}
+	return proto.Marshal(w)
+}
+
+// UnmarshalDateProto decodes the wire bytes of a timestamppb.Timestamp into a Date.
+// Empty input produces an invalid (null) Date.
+func UnmarshalDateProto(data []byte) (libs.Date, error) {
+	if len(data) == 0 {
+		return libs.Date{}, nil
+	}
+	var w timestamppb.Timestamp
+	if err := proto.Unmarshal(data, &w); err != nil {
+		return libs.Date{}, err
+	}
+	return libs.NewDate(w.AsTime()), nil
+}
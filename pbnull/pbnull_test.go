@@ -0,0 +1,177 @@
+package pbnull
+
+import (
+	"testing"
+	"time"
+
+	"go-core/libs"
+)
+
+func TestIntRoundTrip(t *testing.T) {
+	valid := libs.NewInt(42)
+	if got := IntFromWrapperspb(IntToWrapperspb(valid)); got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalIntProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := UnmarshalIntProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+
+	var invalid libs.Int
+	if w := IntToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid Int, got %v", w)
+	}
+	data, err = MarshalIntProto(invalid)
+	if err != nil || data != nil {
+		t.Fatalf("MarshalIntProto(invalid) = %v, %v, want nil, nil", data, err)
+	}
+	got, err = UnmarshalIntProto(nil)
+	if err != nil || got.Valid {
+		t.Fatalf("UnmarshalIntProto(nil) = %+v, %v, want invalid Int, nil error", got, err)
+	}
+}
+
+func TestFloatRoundTrip(t *testing.T) {
+	valid := libs.NewFloat(3.5)
+	if got := FloatFromWrapperspb(FloatToWrapperspb(valid)); got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalFloatProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := UnmarshalFloatProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+
+	var invalid libs.Float
+	if w := FloatToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid Float, got %v", w)
+	}
+	got, err = UnmarshalFloatProto(nil)
+	if err != nil || got.Valid {
+		t.Fatalf("UnmarshalFloatProto(nil) = %+v, %v, want invalid Float, nil error", got, err)
+	}
+}
+
+func TestBoolRoundTrip(t *testing.T) {
+	valid := libs.NewBool(true)
+	if got := BoolFromWrapperspb(BoolToWrapperspb(valid)); got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalBoolProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := UnmarshalBoolProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+
+	var invalid libs.Bool
+	if w := BoolToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid Bool, got %v", w)
+	}
+	got, err = UnmarshalBoolProto(nil)
+	if err != nil || got.Valid {
+		t.Fatalf("UnmarshalBoolProto(nil) = %+v, %v, want invalid Bool, nil error", got, err)
+	}
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	valid := libs.NewString("hello")
+	got := StringFromWrapperspb(StringToWrapperspb(valid))
+	if got.Get() != valid.Get() || got.Valid() != valid.Valid() {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalStringProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = UnmarshalStringProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Get() != "hello" {
+		t.Fatalf("got %q, want hello", got.Get())
+	}
+
+	var invalid libs.String
+	if w := StringToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid String, got %v", w)
+	}
+	got, err = UnmarshalStringProto(nil)
+	if err != nil || got.Valid() {
+		t.Fatalf("UnmarshalStringProto(nil) = %+v, %v, want invalid String, nil error", got, err)
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	valid := libs.NewTime(time.Date(2024, 1, 10, 12, 30, 0, 0, time.UTC))
+	got := TimeFromWrapperspb(TimeToWrapperspb(valid))
+	if !got.Time.Equal(valid.Time) || got.Valid != valid.Valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalTimeProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = UnmarshalTimeProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Time.Equal(valid.Time) {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+
+	var invalid libs.Time
+	if w := TimeToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid Time, got %v", w)
+	}
+	got, err = UnmarshalTimeProto(nil)
+	if err != nil || got.Valid {
+		t.Fatalf("UnmarshalTimeProto(nil) = %+v, %v, want invalid Time, nil error", got, err)
+	}
+}
+
+func TestDateRoundTrip(t *testing.T) {
+	valid := libs.NewDate(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	got := DateFromWrapperspb(DateToWrapperspb(valid))
+	if !got.Time.Equal(valid.Time) || got.Valid != valid.Valid {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+	data, err := MarshalDateProto(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = UnmarshalDateProto(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Time.Equal(valid.Time) {
+		t.Fatalf("got %+v, want %+v", got, valid)
+	}
+
+	var invalid libs.Date
+	if w := DateToWrapperspb(invalid); w != nil {
+		t.Fatalf("expected nil wrapper for invalid Date, got %v", w)
+	}
+	got, err = UnmarshalDateProto(nil)
+	if err != nil || got.Valid {
+		t.Fatalf("UnmarshalDateProto(nil) = %+v, %v, want invalid Date, nil error", got, err)
+	}
+}
@@ -8,6 +8,11 @@ import (
 )
 
 // String NullString is null friendly type for string.
+//
+// Deprecated: NewString("") being invalid is kept here for back-compat.
+// New code should use go-core/libs/zero (identical behaviour, explicit
+// name) or go-core/libs/null (false/0/"" are valid, only JSON/SQL NULL is
+// null).
 type String struct {
 	s sql.NullString
 }
@@ -32,6 +37,15 @@ func NullStringOf(value string) String {
 	return s
 }
 
+// StringFromPtr creates a new String from a *string.
+// A nil pointer produces an invalid (null) String.
+func StringFromPtr(str *string) String {
+	if str == nil {
+		return String{}
+	}
+	return NewString(*str)
+}
+
 // Valid return the value is valid. If true, it is not null value.
 func (s *String) Valid() bool {
 	return s.s.Valid
@@ -106,6 +120,22 @@ func (s String) Get() string {
 	return s.s.String
 }
 
+// ValueOrZero returns the inner value if valid, otherwise "".
+func (s String) ValueOrZero() string {
+	if !s.s.Valid {
+		return ""
+	}
+	return s.s.String
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (s String) OrElse(def string) string {
+	if !s.s.Valid {
+		return def
+	}
+	return s.s.String
+}
+
 // TrimFormatStr 去掉字符串中的空格、换行符、回车符
 func TrimFormatStr(str string) string {
 	// 去除首尾空格
@@ -8,6 +8,7 @@
 package libs
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -19,8 +20,16 @@ import (
 // JSON marshals to the zero value for time.Time if null.
 // Considered to be null to SQL if zero.
 
+// TimeLayOut is the layout used when marshalling a Time to JSON.
+// MarshalText does not use it; it writes time.Time's RFC 3339 text form.
 var TimeLayOut = "2006-01-02 15:04:05"
 
+// TimeParseLayouts is the ordered list of layouts tried, in turn, when
+// unmarshalling a Time from text. RFC3339 is included by default so
+// ISO-8601 payloads are accepted without reconfiguration; append to this
+// slice to support further formats without touching TimeLayOut.
+var TimeParseLayouts = []string{TimeLayOut, time.RFC3339, time.RFC3339Nano}
+
 type Time struct {
 	Time  time.Time
 	Valid bool
@@ -58,6 +67,15 @@ func NewTime(t time.Time) Time {
 	}
 }
 
+// TimeFromPtr creates a new Time from a *time.Time.
+// A nil pointer produces an invalid (null) Time.
+func TimeFromPtr(t *time.Time) Time {
+	if t == nil {
+		return Time{}
+	}
+	return NewTime(*t)
+}
+
 // MarshalJSON implements json.Marshaller.
 // It will encode the zero value of time.Time
 // if this time is invalid.
@@ -69,8 +87,10 @@ func (t Time) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string, object (e.g. pq.NullTime and friends)
-// and null input.
+// It supports string (matched against TimeParseLayouts, so RFC3339/ISO-8601
+// input works by default), a JSON number (treated as a Unix timestamp,
+// seconds or milliseconds depending on magnitude), object (e.g. sql.NullTime
+// and friends) and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
 
 	var value interface{}
@@ -79,9 +99,21 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	switch value.(type) {
+	switch x := value.(type) {
 	case string:
-		return t.UnmarshalText([]byte(value.(string)))
+		return t.UnmarshalText([]byte(x))
+	case float64:
+		t.Time = timeFromUnix(x)
+		t.Valid = true
+		return nil
+	case map[string]interface{}:
+		var nt sql.NullTime
+		if err := json.Unmarshal(data, &nt); err != nil {
+			return err
+		}
+		t.Time = nt.Time
+		t.Valid = nt.Valid
+		return nil
 	case nil:
 		t.Time = time.Time{}
 		t.Valid = false
@@ -108,14 +140,18 @@ func (t *Time) UnmarshalText(text []byte) error {
 		t.Valid = false
 		return nil
 	}
-	tt, err := time.ParseInLocation(TimeLayOut, str, time.Local)
 
-	if err != nil {
-		return err
+	var tt time.Time
+	var err error
+	for _, layout := range TimeParseLayouts {
+		tt, err = time.ParseInLocation(layout, str, time.Local)
+		if err == nil {
+			t.Time = tt
+			t.Valid = true
+			return nil
+		}
 	}
-	t.Time = tt
-	t.Valid = true
-	return nil
+	return err
 }
 
 // SetValid changes this Time's value and
@@ -143,3 +179,31 @@ func (t Time) IsNil() bool {
 func (t Time) Get() time.Time {
 	return t.Time
 }
+
+// ValueOrZero returns the inner value if valid, otherwise the zero time.Time.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (t Time) OrElse(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// timeFromUnix converts a JSON number to a time.Time, auto-detecting
+// whether it represents Unix seconds or Unix milliseconds by magnitude.
+// Timestamps at or past ~33658 AD in seconds (1e12) are vanishingly rare,
+// so anything at or above that magnitude is assumed to be milliseconds.
+func timeFromUnix(n float64) time.Time {
+	if n >= 1e12 || n <= -1e12 {
+		ms := int64(n)
+		return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).Local()
+	}
+	return time.Unix(int64(n), 0).Local()
+}
@@ -0,0 +1,285 @@
+// Package validator is a JSON-path driven validator/mutator for the
+// map[string]interface{} documents and structs that go-core/libs'
+// nullable types typically round-trip through.
+//
+// A caller describes the shape they expect with a slice of FieldRule,
+// each naming a gjson path, then calls Run (for an already-decoded map)
+// or RunForStruct (for a struct, which is marshalled to JSON first).
+// Validation failures are returned as an error; successful runs may also
+// mutate the document in place (defaulting, type coercion) so that it
+// unmarshals cleanly into libs.Int, libs.Float, libs.Bool, libs.String,
+// libs.Time and libs.Date fields afterwards.
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"go-core/libs"
+)
+
+// FieldRule describes the validation/mutation applied to a single JSON path.
+type FieldRule struct {
+	Path     string
+	Required bool
+	Rules    []string
+	Default  interface{}
+}
+
+// Run validates data against rules and mutates it in place.
+func Run(data map[string]interface{}, rules []*FieldRule) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	out, err := validate(raw, rules)
+	if err != nil {
+		return err
+	}
+	result, err := decodeMap(out)
+	if err != nil {
+		return err
+	}
+	for k := range data {
+		delete(data, k)
+	}
+	for k, v := range result {
+		data[k] = v
+	}
+	return nil
+}
+
+// RunForStruct marshals src to JSON, validates/mutates it according to
+// rules, and returns the resulting map. The map is decoded with
+// json.Decoder.UseNumber so integer precision survives the round-trip
+// into libs.Int/libs.Float.
+func RunForStruct(src interface{}, rules []*FieldRule) (map[string]interface{}, error) {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	out, err := validate(raw, rules)
+	if err != nil {
+		return nil, err
+	}
+	return decodeMap(out)
+}
+
+// Bind decodes a validated map (as returned by RunForStruct or mutated by
+// Run) back into dst, typically a struct built from libs nullable types.
+func Bind(validated map[string]interface{}, dst interface{}) error {
+	raw, err := json.Marshal(validated)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+func decodeMap(raw []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var result map[string]interface{}
+	if err := dec.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validate runs every rule against raw in order, returning the (possibly
+// mutated) document or the first validation error encountered.
+func validate(raw []byte, rules []*FieldRule) ([]byte, error) {
+	doc := raw
+	for _, rule := range rules {
+		result := gjson.GetBytes(doc, rule.Path)
+
+		if !result.Exists() || isBlank(result) {
+			if rule.Default != nil {
+				out, err := sjson.SetBytes(doc, rule.Path, rule.Default)
+				if err != nil {
+					return nil, fmt.Errorf("validator: %s: set default: %w", rule.Path, err)
+				}
+				doc = out
+				result = gjson.GetBytes(doc, rule.Path)
+			} else if rule.Required {
+				return nil, fmt.Errorf("validator: %s is required", rule.Path)
+			}
+			// No default and not required: fall through to applyRules so a
+			// "type:*" rule can still null out the blank value explicitly.
+		}
+
+		out, err := applyRules(doc, rule, result)
+		if err != nil {
+			return nil, err
+		}
+		doc = out
+	}
+	return doc, nil
+}
+
+func isBlank(r gjson.Result) bool {
+	if !r.Exists() || r.Type == gjson.Null {
+		return true
+	}
+	return r.Type == gjson.String && r.Str == ""
+}
+
+// applyRules checks every rule in rule.Rules against the current value at
+// rule.Path, coercing the stored value along the way (e.g. "type:int"
+// parses a numeric string into a JSON number, or nulls it out if blank).
+func applyRules(doc []byte, rule *FieldRule, value gjson.Result) ([]byte, error) {
+	for _, r := range rule.Rules {
+		name, arg, _ := strings.Cut(r, ":")
+
+		// A blank, optional value only needs "type" coercion (to null it
+		// out cleanly) and a "required" rule (which rejects it); every
+		// other rule is meaningless against an absent value.
+		if isBlank(value) && name != "type" && name != "required" {
+			continue
+		}
+
+		var err error
+		doc, value, err = applyRule(doc, rule.Path, name, arg, value)
+		if err != nil {
+			return nil, fmt.Errorf("validator: %s: %w", rule.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+func applyRule(doc []byte, path, name, arg string, value gjson.Result) ([]byte, gjson.Result, error) {
+	switch name {
+	case "required":
+		if isBlank(value) {
+			return doc, value, fmt.Errorf("is required")
+		}
+	case "min":
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return doc, value, err
+		}
+		if value.Num < n {
+			return doc, value, fmt.Errorf("must be >= %v", n)
+		}
+	case "max":
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return doc, value, err
+		}
+		if value.Num > n {
+			return doc, value, fmt.Errorf("must be <= %v", n)
+		}
+	case "len":
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return doc, value, err
+		}
+		if len(value.Str) != n {
+			return doc, value, fmt.Errorf("must have length %d", n)
+		}
+	case "regex":
+		re, err := regexp.Compile(arg)
+		if err != nil {
+			return doc, value, err
+		}
+		if !re.MatchString(value.Str) {
+			return doc, value, fmt.Errorf("does not match %s", arg)
+		}
+	case "enum":
+		options := strings.Split(arg, "|")
+		found := false
+		for _, opt := range options {
+			if opt == value.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return doc, value, fmt.Errorf("must be one of %s", arg)
+		}
+	case "type":
+		return coerceType(doc, path, arg, value)
+	case "eq_field":
+		other := gjson.GetBytes(doc, arg)
+		if value.String() != other.String() {
+			return doc, value, fmt.Errorf("must equal field %s", arg)
+		}
+	case "gt_field":
+		other := gjson.GetBytes(doc, arg)
+		if value.Num <= other.Num {
+			return doc, value, fmt.Errorf("must be greater than field %s", arg)
+		}
+	default:
+		return doc, value, fmt.Errorf("unknown rule %q", name)
+	}
+	return doc, value, nil
+}
+
+// coerceType rewrites the value at path so it round-trips cleanly into the
+// named libs type. Blank input is stored as JSON null (an Invalid libs
+// value) rather than erroring.
+func coerceType(doc []byte, path, kind string, value gjson.Result) ([]byte, gjson.Result, error) {
+	if isBlank(value) {
+		out, err := sjson.SetBytes(doc, path, nil)
+		if err != nil {
+			return doc, value, err
+		}
+		return out, gjson.GetBytes(out, path), nil
+	}
+
+	switch kind {
+	case "int":
+		n, err := strconv.ParseInt(strings.TrimSpace(value.String()), 10, 64)
+		if err != nil {
+			return doc, value, fmt.Errorf("must be an int: %w", err)
+		}
+		out, err := sjson.SetBytes(doc, path, n)
+		return afterSet(out, path, err)
+	case "float":
+		n, err := strconv.ParseFloat(strings.TrimSpace(value.String()), 64)
+		if err != nil {
+			return doc, value, fmt.Errorf("must be a float: %w", err)
+		}
+		out, err := sjson.SetBytes(doc, path, n)
+		return afterSet(out, path, err)
+	case "bool":
+		b, err := strconv.ParseBool(strings.TrimSpace(value.String()))
+		if err != nil {
+			return doc, value, fmt.Errorf("must be a bool: %w", err)
+		}
+		out, err := sjson.SetBytes(doc, path, b)
+		return afterSet(out, path, err)
+	case "string":
+		out, err := sjson.SetBytes(doc, path, value.String())
+		return afterSet(out, path, err)
+	case "time":
+		var t libs.Time
+		if err := t.UnmarshalText([]byte(value.String())); err != nil {
+			return doc, value, fmt.Errorf("must be a time: %w", err)
+		}
+		out, err := sjson.SetBytes(doc, path, t.Time.Format(libs.TimeLayOut))
+		return afterSet(out, path, err)
+	case "date":
+		var d libs.Date
+		if err := d.UnmarshalText([]byte(value.String())); err != nil {
+			return doc, value, fmt.Errorf("must be a date: %w", err)
+		}
+		out, err := sjson.SetBytes(doc, path, d.Time.Format(libs.DateTimeLayOut))
+		return afterSet(out, path, err)
+	default:
+		return doc, value, fmt.Errorf("unknown type %q", kind)
+	}
+}
+
+func afterSet(doc []byte, path string, err error) ([]byte, gjson.Result, error) {
+	if err != nil {
+		return doc, gjson.Result{}, err
+	}
+	return doc, gjson.GetBytes(doc, path), nil
+}
@@ -0,0 +1,81 @@
+package validator
+
+import "testing"
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    map[string]interface{}
+		rules   []*FieldRule
+		wantErr bool
+		check   func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name: "blank optional field is nulled by type, not rejected by later rules",
+			data: map[string]interface{}{"amount": ""},
+			rules: []*FieldRule{
+				{Path: "amount", Rules: []string{"type:int", "min:1"}},
+			},
+			check: func(t *testing.T, data map[string]interface{}) {
+				if data["amount"] != nil {
+					t.Fatalf("amount = %v, want nil", data["amount"])
+				}
+			},
+		},
+		{
+			name: "missing required field errors",
+			data: map[string]interface{}{},
+			rules: []*FieldRule{
+				{Path: "name", Required: true, Rules: []string{"type:string"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "default fills a missing field before rule checks run",
+			data: map[string]interface{}{},
+			rules: []*FieldRule{
+				{Path: "status", Default: "pending", Rules: []string{"enum:pending|done"}},
+			},
+			check: func(t *testing.T, data map[string]interface{}) {
+				if data["status"] != "pending" {
+					t.Fatalf("status = %v, want pending", data["status"])
+				}
+			},
+		},
+		{
+			name: "min rule still rejects an out-of-range present value",
+			data: map[string]interface{}{"amount": "0"},
+			rules: []*FieldRule{
+				{Path: "amount", Rules: []string{"type:int", "min:1"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "eq_field compares two present fields",
+			data: map[string]interface{}{"password": "abc", "confirm": "abc"},
+			rules: []*FieldRule{
+				{Path: "confirm", Rules: []string{"eq_field:password"}},
+			},
+		},
+		{
+			name: "eq_field rejects a mismatch",
+			data: map[string]interface{}{"password": "abc", "confirm": "xyz"},
+			rules: []*FieldRule{
+				{Path: "confirm", Rules: []string{"eq_field:password"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := Run(c.data, c.rules)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, c.wantErr)
+			}
+			if err == nil && c.check != nil {
+				c.check(t, c.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package zero
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Int is a nullable int64. Zero input to UnmarshalText will be
+// considered null; a JSON 0 or NewInt(0) is valid.
+// JSON marshals to null if null.
+// Value reports SQL NULL only when invalid.
+type Int struct {
+	sql.NullInt64
+}
+
+// NewInt creates a new Int
+func NewInt(i int64) Int {
+	return Int{
+		NullInt64: sql.NullInt64{
+			Int64: i,
+			Valid: true,
+		},
+	}
+}
+
+// IntFromPtr creates a new Int from a *int64.
+// A nil pointer produces an invalid (null) Int.
+func IntFromPtr(i *int64) Int {
+	if i == nil {
+		return Int{}
+	}
+	return NewInt(*i)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// A JSON 0 is a valid Int; only UnmarshalText treats 0 as null.
+// It also supports unmarshalling a sql.NullInt64.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		// Unmarshal again, directly to int64, to avoid intermediate float64
+		err = json.Unmarshal(data, &i.Int64)
+	case string:
+		if len(x) == 0 {
+			i.Valid = false
+			return nil
+		}
+
+		i.Int64, err = strconv.ParseInt(x, 10, 64)
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt64)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Int", reflect.TypeOf(v).Name())
+	}
+	i.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int if the input is a blank, zero, or not an integer.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Int) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	var err error
+	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
+	i.Valid = (err == nil) && (i.Int64 != 0)
+	return err
+}
+
+// MarshalJSON implements json.Marshaller.
+// It will encode null if this Int is null.
+func (i Int) MarshalJSON() ([]byte, error) {
+	n := i.Int64
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaller.
+// It will encode null if this Int is null.
+func (i Int) MarshalText() ([]byte, error) {
+	n := i.Int64
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// SetValid changes this Int value and also sets it to be non-null.
+func (i *Int) SetValid(n int64) {
+	i.Int64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int value, or a nil pointer if this Int is null.
+func (i Int) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+// IsNil returns true only for a null Int, for future omitempty support (Go 1.4?)
+func (i Int) IsNil() bool {
+	return !i.Valid
+}
+
+// Get 获取值
+func (i *Int) Get() int {
+	return int(i.Int64)
+}
+
+func (i *Int) String() string {
+	return strconv.Itoa(i.Get())
+}
+
+// ValueOrZero returns the inner value if valid, otherwise 0.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// OrElse returns the inner value if valid, otherwise the given default.
+func (i Int) OrElse(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
@@ -0,0 +1,65 @@
+package zero
+
+import "testing"
+
+func TestIntUnmarshalTextZero(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalText([]byte("0")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if i.Valid {
+		t.Fatal(`unmarshalling text "0" should leave Int invalid under zero-is-null semantics`)
+	}
+
+	if err := i.UnmarshalText([]byte("5")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !i.Valid || i.Int64 != 5 {
+		t.Fatalf("got %+v, want valid 5", i)
+	}
+}
+
+func TestBoolUnmarshalTextBlank(t *testing.T) {
+	var b Bool
+	if err := b.UnmarshalText([]byte("")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.Valid {
+		t.Fatal("unmarshalling blank text should leave Bool invalid")
+	}
+
+	if err := b.UnmarshalText([]byte("true")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !b.Valid || !b.Bool {
+		t.Fatalf("got %+v, want valid true", b)
+	}
+}
+
+func TestFloatUnmarshalTextZero(t *testing.T) {
+	var f Float
+	if err := f.UnmarshalText([]byte("0")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.Valid {
+		t.Fatal(`unmarshalling text "0" should leave Float invalid under zero-is-null semantics`)
+	}
+
+	if err := f.UnmarshalText([]byte("1.5")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !f.Valid || f.Float64 != 1.5 {
+		t.Fatalf("got %+v, want valid 1.5", f)
+	}
+}
+
+func TestNewStringEmptyIsInvalid(t *testing.T) {
+	s := NewString("")
+	if s.Valid() {
+		t.Fatal(`NewString("") should be invalid under zero-is-null semantics`)
+	}
+	hi := NewString("hi")
+	if !hi.Valid() {
+		t.Fatal(`NewString("hi") should be valid`)
+	}
+}